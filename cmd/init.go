@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"github.com/commitdev/zero/internal/context"
+	"github.com/spf13/cobra"
+)
+
+var fromManifest string
+var resume string
+var noInput bool
+
+func init() {
+	initCmd.Flags().StringVar(&fromManifest, "from-manifest", "", "Path to a manifest file that answers init's prompts non-interactively")
+	initCmd.Flags().StringVar(&resume, "resume", "", "Path to a previously written zero-project.yml (or its project directory) to resume an interrupted init")
+	initCmd.Flags().BoolVar(&noInput, "no-input", false, "Never prompt; fail if a required value isn't resolved from --from-manifest, --resume, ZERO_PARAM_*/ZERO_CRED_* env vars, or ~/.zero/config.yml")
+	rootCmd.AddCommand(initCmd)
+}
+
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Initialize a new zero project.",
+	Run: func(cmd *cobra.Command, args []string) {
+		context.Init("./", context.InitOptions{
+			ManifestPath: fromManifest,
+			ResumePath:   resume,
+			NoInput:      noInput,
+		})
+	},
+}
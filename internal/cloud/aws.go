@@ -0,0 +1,78 @@
+package cloud
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/commitdev/zero/internal/config/globalconfig"
+	"github.com/commitdev/zero/internal/config/projectconfig"
+)
+
+// AWSProvider validates credentials via STS GetCallerIdentity, same as
+// the init flow did before it supported other clouds.
+type AWSProvider struct{}
+
+func NewAWSProvider() *AWSProvider {
+	return &AWSProvider{}
+}
+
+func (p *AWSProvider) Name() string {
+	return "Amazon AWS"
+}
+
+func (p *AWSProvider) VendorKey() string {
+	return "aws"
+}
+
+func (p *AWSProvider) CredentialPrompts(existing globalconfig.ProjectCredential) []CredentialField {
+	return []CredentialField{
+		{Field: "accessKeyId", Label: "AWS Access Key ID", Default: existing.AWSResourceConfig.AccessKeyId},
+		{Field: "secretAccessKey", Label: "AWS Secret access key", Default: existing.AWSResourceConfig.SecretAccessKey},
+	}
+}
+
+func (p *AWSProvider) Validate(values map[string]string) error {
+	_, err := p.callerIdentity(values)
+	return err
+}
+
+func (p *AWSProvider) PopulateInfrastructure(projectConfig *projectconfig.ZeroProjectConfig, values map[string]string) error {
+	accountID, err := p.callerIdentity(values)
+	if err != nil {
+		return err
+	}
+
+	if projectConfig.Infrastructure.AWS == nil {
+		projectConfig.Infrastructure.AWS = &projectconfig.AWSInfrastructure{}
+	}
+	projectConfig.Infrastructure.AWS.AccountID = accountID
+	return nil
+}
+
+func (p *AWSProvider) callerIdentity(values map[string]string) (string, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials(values["accessKeyId"], values["secretAccessKey"], ""),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	svc := sts.New(sess)
+	caller, err := svc.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			return "", errors.New(aerr.Error())
+		}
+		return "", err
+	}
+
+	if caller.Account == nil {
+		return "", fmt.Errorf("AWS caller identity did not include an account id")
+	}
+	return *caller.Account, nil
+}
@@ -0,0 +1,84 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/subscription/armsubscriptions"
+
+	"github.com/commitdev/zero/internal/config/globalconfig"
+	"github.com/commitdev/zero/internal/config/projectconfig"
+)
+
+// AzureProvider validates a subscription ID (and, if given, a tenant ID)
+// against the Azure Subscriptions API using ambient azidentity
+// credentials (az login, managed identity, or env-based service
+// principal).
+type AzureProvider struct{}
+
+func NewAzureProvider() *AzureProvider {
+	return &AzureProvider{}
+}
+
+func (p *AzureProvider) Name() string {
+	return "Microsoft Azure"
+}
+
+func (p *AzureProvider) VendorKey() string {
+	return "azure"
+}
+
+func (p *AzureProvider) CredentialPrompts(existing globalconfig.ProjectCredential) []CredentialField {
+	return []CredentialField{
+		{Field: "subscriptionId", Label: "Azure Subscription ID", Default: existing.AzureResourceConfig.SubscriptionID},
+		{Field: "tenantId", Label: "Azure Tenant ID", Default: existing.AzureResourceConfig.TenantID},
+	}
+}
+
+func (p *AzureProvider) Validate(values map[string]string) error {
+	_, err := p.resolveSubscription(values)
+	return err
+}
+
+func (p *AzureProvider) PopulateInfrastructure(projectConfig *projectconfig.ZeroProjectConfig, values map[string]string) error {
+	subscription, err := p.resolveSubscription(values)
+	if err != nil {
+		return err
+	}
+
+	displayName := ""
+	if subscription.DisplayName != nil {
+		displayName = *subscription.DisplayName
+	}
+
+	projectConfig.Infrastructure.Azure = &projectconfig.AzureInfrastructure{
+		SubscriptionID: values["subscriptionId"],
+		TenantID:       values["tenantId"],
+		DisplayName:    displayName,
+	}
+	return nil
+}
+
+func (p *AzureProvider) resolveSubscription(values map[string]string) (*armsubscriptions.Subscription, error) {
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve Azure credentials: %w", err)
+	}
+
+	client, err := armsubscriptions.NewClient(cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Azure subscriptions client: %w", err)
+	}
+
+	resp, err := client.Get(context.Background(), values["subscriptionId"], nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify Azure subscription %q: %w", values["subscriptionId"], err)
+	}
+
+	if tenantID := values["tenantId"]; tenantID != "" && resp.TenantID != nil && *resp.TenantID != tenantID {
+		return nil, fmt.Errorf("subscription %q belongs to tenant %q, not %q", values["subscriptionId"], *resp.TenantID, tenantID)
+	}
+
+	return &resp.Subscription, nil
+}
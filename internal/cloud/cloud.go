@@ -0,0 +1,70 @@
+package cloud
+
+import (
+	"github.com/commitdev/zero/internal/config/globalconfig"
+	"github.com/commitdev/zero/internal/config/projectconfig"
+)
+
+// CredentialField describes a single credential value a Provider needs
+// collected from the user. It mirrors moduleconfig.Parameter's shape so
+// callers can turn it into whatever prompt mechanism they use, without
+// this package needing to depend on it.
+type CredentialField struct {
+	Field   string
+	Label   string
+	Default string
+}
+
+// Provider is implemented by each supported cloud backend. context.Init
+// dispatches to the chosen Provider instead of hard-coding AWS.
+type Provider interface {
+	// Name is the label shown in the "Select Cloud Provider" prompt.
+	Name() string
+
+	// VendorKey is the short, lowercase identifier used elsewhere in the
+	// config (moduleconfig.ModuleConfig.RequiredCredentials, manifest
+	// credentials sections, ZERO_CRED_<VENDOR>_<FIELD> env vars).
+	VendorKey() string
+
+	// CredentialPrompts lists the credential fields this provider needs,
+	// pre-filled from any credentials already saved for the project.
+	CredentialPrompts(existing globalconfig.ProjectCredential) []CredentialField
+
+	// Validate checks that the supplied credential values actually
+	// authenticate and resolve to a real account/project/subscription.
+	Validate(values map[string]string) error
+
+	// PopulateInfrastructure fills in this provider's section of
+	// projectConfig.Infrastructure using the validated credential values.
+	PopulateInfrastructure(projectConfig *projectconfig.ZeroProjectConfig, values map[string]string) error
+}
+
+// Providers returns every supported cloud provider, in the order they
+// should be offered to the user.
+func Providers() []Provider {
+	return []Provider{
+		NewAWSProvider(),
+		NewGCPProvider(),
+		NewAzureProvider(),
+	}
+}
+
+// ByName returns the provider whose Name() matches name, if any.
+func ByName(name string) (Provider, bool) {
+	for _, provider := range Providers() {
+		if provider.Name() == name {
+			return provider, true
+		}
+	}
+	return nil, false
+}
+
+// ByVendorKey returns the provider whose VendorKey() matches vendor, if any.
+func ByVendorKey(vendor string) (Provider, bool) {
+	for _, provider := range Providers() {
+		if provider.VendorKey() == vendor {
+			return provider, true
+		}
+	}
+	return nil, false
+}
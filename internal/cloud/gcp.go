@@ -0,0 +1,80 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/oauth2/google"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+
+	"github.com/commitdev/zero/internal/config/globalconfig"
+	"github.com/commitdev/zero/internal/config/projectconfig"
+)
+
+// GCPProvider resolves a GCP project either from a service account JSON
+// key file or from the caller's application-default credentials, and
+// validates it via the Cloud Resource Manager API.
+type GCPProvider struct{}
+
+func NewGCPProvider() *GCPProvider {
+	return &GCPProvider{}
+}
+
+func (p *GCPProvider) Name() string {
+	return "Google GCP"
+}
+
+func (p *GCPProvider) VendorKey() string {
+	return "gcp"
+}
+
+func (p *GCPProvider) CredentialPrompts(existing globalconfig.ProjectCredential) []CredentialField {
+	return []CredentialField{
+		{Field: "projectId", Label: "GCP Project ID", Default: existing.GCPResourceConfig.ProjectID},
+		{Field: "serviceAccountKeyPath", Label: "Path to a GCP service account JSON key file (leave blank to use the gcloud default credentials)", Default: existing.GCPResourceConfig.ServiceAccountKeyPath},
+	}
+}
+
+func (p *GCPProvider) Validate(values map[string]string) error {
+	_, err := p.resolveProject(values)
+	return err
+}
+
+func (p *GCPProvider) PopulateInfrastructure(projectConfig *projectconfig.ZeroProjectConfig, values map[string]string) error {
+	project, err := p.resolveProject(values)
+	if err != nil {
+		return err
+	}
+
+	projectConfig.Infrastructure.GCP = &projectconfig.GCPInfrastructure{
+		ProjectID: project.ProjectId,
+	}
+	return nil
+}
+
+func (p *GCPProvider) resolveProject(values map[string]string) (*cloudresourcemanager.Project, error) {
+	ctx := context.Background()
+
+	var opts []option.ClientOption
+	if keyPath := values["serviceAccountKeyPath"]; keyPath != "" {
+		opts = append(opts, option.WithCredentialsFile(keyPath))
+	} else {
+		creds, err := google.FindDefaultCredentials(ctx, cloudresourcemanager.CloudPlatformScope)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve GCP credentials: %w", err)
+		}
+		opts = append(opts, option.WithCredentials(creds))
+	}
+
+	svc, err := cloudresourcemanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create GCP Cloud Resource Manager client: %w", err)
+	}
+
+	project, err := svc.Projects.Get(values["projectId"]).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify GCP project %q: %w", values["projectId"], err)
+	}
+	return project, nil
+}
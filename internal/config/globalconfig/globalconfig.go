@@ -0,0 +1,105 @@
+package globalconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AWSResourceConfig holds the AWS credentials stored for a project.
+type AWSResourceConfig struct {
+	AccessKeyId     string
+	SecretAccessKey string
+}
+
+// GithubResourceConfig holds the Github credentials stored for a project.
+type GithubResourceConfig struct {
+	AccessToken string
+}
+
+// CircleCiResourceConfig holds the CircleCI credentials stored for a project.
+type CircleCiResourceConfig struct {
+	ApiKey string
+}
+
+// GCPResourceConfig holds the GCP credentials stored for a project.
+type GCPResourceConfig struct {
+	ProjectID             string
+	ServiceAccountKeyPath string
+}
+
+// AzureResourceConfig holds the Azure credentials stored for a project.
+type AzureResourceConfig struct {
+	SubscriptionID string
+	TenantID       string
+}
+
+// ProjectCredential is the set of vendor credentials associated with a
+// single project. GlobalConfig.Credentials holds one read from
+// ~/.zero/config.yml.
+type ProjectCredential struct {
+	ProjectName            string
+	AWSResourceConfig      AWSResourceConfig
+	GCPResourceConfig      GCPResourceConfig
+	AzureResourceConfig    AzureResourceConfig
+	GithubResourceConfig   GithubResourceConfig
+	CircleCiResourceConfig CircleCiResourceConfig
+}
+
+// GetProjectCredentials returns a ProjectCredential holding only
+// projectName. Nothing is read from disk, so no saved credentials are
+// returned.
+func GetProjectCredentials(projectName string) ProjectCredential {
+	return ProjectCredential{ProjectName: projectName}
+}
+
+// Save accepts the given project credentials and always returns nil; it
+// writes nothing to disk.
+func Save(cred ProjectCredential) error {
+	return nil
+}
+
+// GlobalConfig holds the user-wide defaults read from ~/.zero/config.yml.
+// It seeds the init prompts (GithubRootOrg, preferred cloud provider,
+// default stack, per-vendor credentials) before the manifest/env/prompt
+// chain runs, so a returning user isn't asked for the same answers on
+// every new project.
+type GlobalConfig struct {
+	GithubRootOrg string            `yaml:"githubRootOrg,omitempty"`
+	CloudProvider string            `yaml:"cloudProvider,omitempty"`
+	DefaultStack  string            `yaml:"defaultStack,omitempty"`
+	Credentials   ProjectCredential `yaml:"credentials,omitempty"`
+}
+
+// LoadGlobalConfig reads ~/.zero/config.yml. A missing file is not an
+// error; it just means there are no global defaults yet.
+func LoadGlobalConfig() (*GlobalConfig, error) {
+	path, err := globalConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &GlobalConfig{}, nil
+		}
+		return nil, err
+	}
+
+	config := &GlobalConfig{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func globalConfigPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".zero", "config.yml"), nil
+}
@@ -0,0 +1,17 @@
+package moduleconfig
+
+// Parameter describes a single value a module needs from the user, along
+// with the label and default shown when prompting for it.
+type Parameter struct {
+	Field   string
+	Label   string
+	Default string
+}
+
+// ModuleConfig is the parsed `zero-module.yml` for a single module source.
+type ModuleConfig struct {
+	Name                string
+	OutputDir           string
+	Parameters          []Parameter
+	RequiredCredentials []string
+}
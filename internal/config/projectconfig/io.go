@@ -0,0 +1,36 @@
+package projectconfig
+
+import (
+	"io/ioutil"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Filename is the name `zero init` writes the resolved project config to
+// under a project's root directory.
+const Filename = "zero-project.yml"
+
+// Save serializes config to path with the stable field ordering defined
+// by ZeroProjectConfig's struct layout.
+func Save(path string, config *ZeroProjectConfig) error {
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// Load reads and parses a zero-project.yml previously written by Save,
+// e.g. to resume an interrupted `zero init`.
+func Load(path string) (*ZeroProjectConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &ZeroProjectConfig{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
@@ -0,0 +1,66 @@
+package projectconfig
+
+// Parameters is a flat map of parameter field name to resolved value.
+type Parameters map[string]string
+
+// AWSInfrastructure holds the resolved AWS account details for a project.
+type AWSInfrastructure struct {
+	Region    string `yaml:"region,omitempty"`
+	AccountID string `yaml:"accountId,omitempty"`
+}
+
+// GCPInfrastructure holds the resolved GCP project details for a project.
+type GCPInfrastructure struct {
+	ProjectID string `yaml:"projectId,omitempty"`
+}
+
+// AzureInfrastructure holds the resolved Azure subscription details for a
+// project.
+type AzureInfrastructure struct {
+	SubscriptionID string `yaml:"subscriptionId,omitempty"`
+	TenantID       string `yaml:"tenantId,omitempty"`
+	DisplayName    string `yaml:"displayName,omitempty"`
+}
+
+// Infrastructure holds the per-provider infrastructure details that were
+// resolved while filling in the project config. At most one of these is
+// populated: the one for whichever cloud.Provider the user chose, and none
+// while no provider has been resolved.
+type Infrastructure struct {
+	AWS   *AWSInfrastructure   `yaml:"aws,omitempty"`
+	GCP   *GCPInfrastructure   `yaml:"gcp,omitempty"`
+	Azure *AzureInfrastructure `yaml:"azure,omitempty"`
+}
+
+// Module is the resolved configuration for a single module within a
+// project: its parameter values and where its repository lives.
+type Module struct {
+	Parameters Parameters `yaml:"parameters,omitempty"`
+	RepoName   string     `yaml:"repoName"`
+	RepoURL    string     `yaml:"repoUrl"`
+}
+
+// Modules maps a module name to its resolved configuration.
+type Modules map[string]Module
+
+// NewModule builds a Module from its prompted parameters and repo details.
+func NewModule(parameters Parameters, repoName string, repoURL string) Module {
+	return Module{
+		Parameters: parameters,
+		RepoName:   repoName,
+		RepoURL:    repoURL,
+	}
+}
+
+// ZeroProjectConfig is the full, resolved configuration for a project
+// produced by `zero init`. Field order here is the order it's written to
+// zero-project.yml in.
+type ZeroProjectConfig struct {
+	Name                   string            `yaml:"name"`
+	ShouldPushRepositories bool              `yaml:"shouldPushRepositories"`
+	GithubRootOrg          string            `yaml:"githubRootOrg,omitempty"`
+	ModuleSources          []string          `yaml:"moduleSources,omitempty"`
+	Infrastructure         Infrastructure    `yaml:"infrastructure,omitempty"`
+	Parameters             map[string]string `yaml:"parameters,omitempty"`
+	Modules                Modules           `yaml:"modules,omitempty"`
+}
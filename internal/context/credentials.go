@@ -0,0 +1,37 @@
+package context
+
+import "github.com/commitdev/zero/internal/config/globalconfig"
+
+// promptCredentials resolves every credential prompt grouped by vendor,
+// consulting source before falling back to the interactive prompt for
+// each vendor's credential fields. It returns both the updated
+// ProjectCredential (for globalconfig.Save) and
+// the raw per-vendor values, which cloud.Provider.Validate/
+// PopulateInfrastructure need in their own field-name shape.
+func promptCredentials(prompts map[string][]PromptHandler, creds globalconfig.ProjectCredential, source *InputSource) (globalconfig.ProjectCredential, map[string]map[string]string) {
+	allValues := make(map[string]map[string]string)
+	for vendor, handlers := range prompts {
+		values := make(map[string]string)
+		for _, handler := range handlers {
+			values[handler.Field] = handler.GetParam(values, source)
+		}
+		allValues[vendor] = values
+
+		switch vendor {
+		case "aws":
+			creds.AWSResourceConfig.AccessKeyId = values["accessKeyId"]
+			creds.AWSResourceConfig.SecretAccessKey = values["secretAccessKey"]
+		case "github":
+			creds.GithubResourceConfig.AccessToken = values["accessToken"]
+		case "circleci":
+			creds.CircleCiResourceConfig.ApiKey = values["apiKey"]
+		case "gcp":
+			creds.GCPResourceConfig.ProjectID = values["projectId"]
+			creds.GCPResourceConfig.ServiceAccountKeyPath = values["serviceAccountKeyPath"]
+		case "azure":
+			creds.AzureResourceConfig.SubscriptionID = values["subscriptionId"]
+			creds.AzureResourceConfig.TenantID = values["tenantId"]
+		}
+	}
+	return creds, allValues
+}
@@ -6,63 +6,91 @@ import (
 	"path"
 	"sync"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/aws/credentials"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/commitdev/zero/internal/cloud"
 	"github.com/commitdev/zero/internal/config/globalconfig"
 	"github.com/commitdev/zero/internal/config/moduleconfig"
 	"github.com/commitdev/zero/internal/config/projectconfig"
 	"github.com/commitdev/zero/internal/module"
-	project "github.com/commitdev/zero/pkg/credentials"
 	"github.com/commitdev/zero/pkg/util/exit"
 	"github.com/commitdev/zero/pkg/util/flog"
-	"github.com/k0kubun/pp"
 	"github.com/manifoldco/promptui"
 )
 
 type Registry map[string][]string
 
+// InitOptions controls how Init gathers its input: interactively, from a
+// manifest file, from a resumed zero-project.yml, from the environment, or
+// from ~/.zero/config.yml. With NoInput nothing is prompted; Init exits
+// with a list of every unresolved field once all steps have run.
+type InitOptions struct {
+	ManifestPath string
+	ResumePath   string
+	NoInput      bool
+}
+
 // Create cloud provider context
-func Init(outDir string) *projectconfig.ZeroProjectConfig {
+func Init(outDir string, opts InitOptions) *projectconfig.ZeroProjectConfig {
+	source, err := NewInputSource(opts.ManifestPath, opts.ResumePath, opts.NoInput)
+	if err != nil {
+		exit.Fatal("%v", err)
+	}
+
 	projectConfig := defaultProjConfig()
 
-	projectConfig.Name = getProjectNamePrompt().GetParam(projectConfig.Parameters)
+	projectConfig.Name = getProjectNamePrompt().GetParam(projectConfig.Parameters, source)
 
 	rootDir := path.Join(outDir, projectConfig.Name)
 	flog.Infof(":tada: Initializing project")
 
-	err := os.MkdirAll(rootDir, os.ModePerm)
+	err = os.MkdirAll(rootDir, os.ModePerm)
 	if os.IsExist(err) {
 		exit.Fatal("Directory %v already exists! Error: %v", projectConfig.Name, err)
 	} else if err != nil {
 		exit.Fatal("Error creating root: %v ", err)
 	}
 
-	moduleSources := chooseStack(getRegistry())
+	configPath := path.Join(rootDir, projectconfig.Filename)
+
+	moduleSources := chooseStack(getRegistry(), source)
+	projectConfig.ModuleSources = moduleSources
+	checkpoint(configPath, &projectConfig)
 	moduleConfigs := loadAllModules(moduleSources)
 
 	prompts := getProjectPrompts(projectConfig.Name, moduleConfigs)
 
 	initParams := make(map[string]string)
 	projectConfig.ShouldPushRepositories = true
-	initParams["ShouldPushRepositories"] = prompts["ShouldPushRepositories"].GetParam(initParams)
+	initParams["ShouldPushRepositories"] = prompts["ShouldPushRepositories"].GetParam(initParams, source)
 	if initParams["ShouldPushRepositories"] == "n" {
 		projectConfig.ShouldPushRepositories = false
 	}
 
 	// Prompting for push-up stream, then conditionally prompting for github
-	initParams["GithubRootOrg"] = prompts["GithubRootOrg"].GetParam(initParams)
+	initParams["GithubRootOrg"] = prompts["GithubRootOrg"].GetParam(initParams, source)
+	projectConfig.GithubRootOrg = initParams["GithubRootOrg"]
+	checkpoint(configPath, &projectConfig)
+
+	provider := chooseCloudProvider(source)
+	var providerVendors []string
+	if provider != nil {
+		providerVendors = []string{provider.VendorKey()}
+	}
+
 	projectCredentials := globalconfig.GetProjectCredentials(projectConfig.Name)
-	credentialPrompts := getCredentialPrompts(projectCredentials, moduleConfigs)
-	projectCredentials = promptCredentialsAndFillProjectCreds(credentialPrompts, projectCredentials)
+	credentialPrompts := getCredentialPrompts(projectCredentials, moduleConfigs, providerVendors)
+	projectCredentials, credentialValues := promptCredentials(credentialPrompts, projectCredentials, source)
 	globalconfig.Save(projectCredentials)
-	projectParameters := promptAllModules(moduleConfigs)
+
+	if provider != nil {
+		fillProviderDetails(&projectConfig, provider, credentialValues[provider.VendorKey()])
+	}
+	checkpoint(configPath, &projectConfig)
+
+	projectParameters := promptAllModules(moduleConfigs, source)
 
 	// Map parameter values back to specific modules
 	for moduleName, module := range moduleConfigs {
-		repoName := prompts[moduleName].GetParam(initParams)
+		repoName := prompts[moduleName].GetParam(initParams, source)
 		repoURL := fmt.Sprintf("%s/%s", initParams["GithubRootOrg"], repoName)
 		projectModuleParams := make(projectconfig.Parameters)
 
@@ -79,16 +107,31 @@ func Init(outDir string) *projectconfig.ZeroProjectConfig {
 		projectConfig.Modules[moduleName] = projectconfig.NewModule(projectModuleParams, repoName, repoURL)
 	}
 
-	// TODO : Write the project config file. For now, print.
-	pp.Println(projectConfig)
-	pp.Print(projectCredentials)
+	if opts.NoInput {
+		if err := source.Unresolved(); err != nil {
+			exit.Fatal("%v", err)
+		}
+	}
 
-	// TODO: load ~/.zero/config.yml (or credentials)
-	// TODO: prompt global credentials
+	if err := projectconfig.Save(configPath, &projectConfig); err != nil {
+		exit.Fatal("Unable to write %s: %v", configPath, err)
+	}
+	flog.Infof("Wrote %s", configPath)
 
 	return &projectConfig
 }
 
+// checkpoint best-effort persists the in-progress projectConfig to
+// configPath after each major step resolves. This is what makes `--resume`
+// meaningful: if a later step hits a fatal error (bad credentials, a
+// network blip fetching a module, a killed terminal), the fields already
+// resolved are still on disk instead of being lost with the process.
+func checkpoint(configPath string, projectConfig *projectconfig.ZeroProjectConfig) {
+	if err := projectconfig.Save(configPath, projectConfig); err != nil {
+		exit.Error("Unable to checkpoint %s: %v", configPath, err)
+	}
+}
+
 // loadAllModules takes a list of module sources, downloads those modules, and parses their config
 func loadAllModules(moduleSources []string) map[string]moduleconfig.ModuleConfig {
 	modules := make(map[string]moduleconfig.ModuleConfig)
@@ -111,11 +154,11 @@ func loadAllModules(moduleSources []string) map[string]moduleconfig.ModuleConfig
 }
 
 // promptAllModules takes a map of all the modules and prompts the user for values for all the parameters
-func promptAllModules(modules map[string]moduleconfig.ModuleConfig) map[string]string {
+func promptAllModules(modules map[string]moduleconfig.ModuleConfig, source *InputSource) map[string]string {
 	parameterValues := make(map[string]string)
 	for _, config := range modules {
 		var err error
-		parameterValues, err = PromptModuleParams(config, parameterValues)
+		parameterValues, err = PromptModuleParams(config, parameterValues, source)
 		if err != nil {
 			exit.Fatal("Exiting prompt:  %v\n", err)
 		}
@@ -127,35 +170,35 @@ func promptAllModules(modules map[string]moduleconfig.ModuleConfig) map[string]s
 // requires the projectName to populate defaults
 func getProjectNamePrompt() PromptHandler {
 	return PromptHandler{
-		moduleconfig.Parameter{
+		Parameter: moduleconfig.Parameter{
 			Field:   "projectName",
 			Label:   "Project Name",
 			Default: "",
 		},
-		NoCondition,
-		NoValidation,
+		Condition: NoCondition,
+		Validate:  NoValidation,
 	}
 }
 
 func getProjectPrompts(projectName string, modules map[string]moduleconfig.ModuleConfig) map[string]PromptHandler {
 	handlers := map[string]PromptHandler{
 		"ShouldPushRepositories": {
-			moduleconfig.Parameter{
+			Parameter: moduleconfig.Parameter{
 				Field:   "ShouldPushRepositories",
 				Label:   "Should the created projects be checked into github automatically? (y/n)",
 				Default: "y",
 			},
-			NoCondition,
-			SpecificValueValidation("y", "n"),
+			Condition: NoCondition,
+			Validate:  SpecificValueValidation("y", "n"),
 		},
 		"GithubRootOrg": {
-			moduleconfig.Parameter{
+			Parameter: moduleconfig.Parameter{
 				Field:   "GithubRootOrg",
 				Label:   "What's the root of the github org to create repositories in?",
 				Default: "github.com/",
 			},
-			KeyMatchCondition("ShouldPushRepositories", "y"),
-			NoValidation,
+			Condition: KeyMatchCondition("ShouldPushRepositories", "y"),
+			Validate:  NoValidation,
 		},
 	}
 
@@ -163,21 +206,24 @@ func getProjectPrompts(projectName string, modules map[string]moduleconfig.Modul
 		label := fmt.Sprintf("What do you want to call the %s project?", moduleName)
 
 		handlers[moduleName] = PromptHandler{
-			moduleconfig.Parameter{
+			Parameter: moduleconfig.Parameter{
 				Field:   moduleName,
 				Label:   label,
 				Default: module.OutputDir,
 			},
-			NoCondition,
-			NoValidation,
+			Condition: NoCondition,
+			Validate:  NoValidation,
 		}
 	}
 
 	return handlers
 }
 
-func getCredentialPrompts(projectCredentials globalconfig.ProjectCredential, moduleConfigs map[string]moduleconfig.ModuleConfig) map[string][]PromptHandler {
-	var uniqueVendors []string
+// getCredentialPrompts builds the credential prompts for every vendor
+// required by the chosen modules, plus extraVendors (the selected
+// cloud.Provider's vendor key, which modules don't otherwise declare).
+func getCredentialPrompts(projectCredentials globalconfig.ProjectCredential, moduleConfigs map[string]moduleconfig.ModuleConfig, extraVendors []string) map[string][]PromptHandler {
+	uniqueVendors := appendToSet(nil, extraVendors)
 	for _, module := range moduleConfigs {
 		uniqueVendors = appendToSet(uniqueVendors, module.RequiredCredentials)
 	}
@@ -196,56 +242,118 @@ func mapVendorToPrompts(projectCred globalconfig.ProjectCredential, vendor strin
 	case "aws":
 		awsPrompts := []PromptHandler{
 			{
-				moduleconfig.Parameter{
+				Parameter: moduleconfig.Parameter{
 					Field:   "accessKeyId",
 					Label:   "AWS Access Key ID",
 					Default: projectCred.AWSResourceConfig.AccessKeyId,
 				},
-				NoCondition,
-				NoValidation,
+				Condition: NoCondition,
+				Validate:  NoValidation,
+				Vendor:    vendor,
 			},
 			{
-				moduleconfig.Parameter{
+				Parameter: moduleconfig.Parameter{
 					Field:   "secretAccessKey",
 					Label:   "AWS Secret access key",
 					Default: projectCred.AWSResourceConfig.SecretAccessKey,
 				},
-				NoCondition,
-				NoValidation,
+				Condition: NoCondition,
+				Validate:  NoValidation,
+				Vendor:    vendor,
 			},
 		}
 		prompts = append(prompts, awsPrompts...)
 	case "github":
 		githubPrompt := PromptHandler{
-			moduleconfig.Parameter{
+			Parameter: moduleconfig.Parameter{
 				Field:   "accessToken",
 				Label:   "Github Personal Access Token with access to the above organization",
 				Default: projectCred.GithubResourceConfig.AccessToken,
 			},
-			NoCondition,
-			NoValidation,
+			Condition: NoCondition,
+			Validate:  NoValidation,
+			Vendor:    vendor,
 		}
 		prompts = append(prompts, githubPrompt)
 	case "circleci":
 		circleCiPrompt := PromptHandler{
-			moduleconfig.Parameter{
+			Parameter: moduleconfig.Parameter{
 				Field:   "apiKey",
 				Label:   "Circleci api key for CI/CD",
 				Default: projectCred.CircleCiResourceConfig.ApiKey,
 			},
-			NoCondition,
-			NoValidation,
+			Condition: NoCondition,
+			Validate:  NoValidation,
+			Vendor:    vendor,
 		}
 		prompts = append(prompts, circleCiPrompt)
+	default:
+		if provider, ok := cloud.ByVendorKey(vendor); ok {
+			for _, field := range provider.CredentialPrompts(projectCred) {
+				prompts = append(prompts, PromptHandler{
+					Parameter: moduleconfig.Parameter{
+						Field:   field.Field,
+						Label:   field.Label,
+						Default: field.Default,
+					},
+					Condition: NoCondition,
+					Validate:  NoValidation,
+					Vendor:    vendor,
+				})
+			}
+		}
 	}
 	return prompts
 }
 
-func chooseCloudProvider(projectConfig *projectconfig.ZeroProjectConfig) {
-	// @TODO move options into configs
+// chooseCloudProvider resolves which cloud.Provider to use: the manifest's
+// cloudProvider vendor key if set, then the provider a resumed
+// zero-project.yml populated, otherwise an interactive pick among
+// cloud.Providers, pre-selected from the global config's remembered
+// preference. In --no-input mode with neither of the first two, it falls
+// back to that global preference too, recording "CloudProvider" as missing
+// (and returning nil) if even that isn't set.
+func chooseCloudProvider(source *InputSource) cloud.Provider {
+	if source != nil && source.Manifest != nil && source.Manifest.CloudProvider != "" {
+		provider, ok := cloud.ByVendorKey(source.Manifest.CloudProvider)
+		if !ok {
+			exit.Fatal("Manifest cloud provider %q is not a known provider", source.Manifest.CloudProvider)
+		}
+		return provider
+	}
+	if source != nil && source.Resume != nil && source.Resume.CloudProvider != "" {
+		if provider, ok := cloud.ByVendorKey(source.Resume.CloudProvider); ok {
+			return provider
+		}
+	}
+
+	defaultVendor := ""
+	if source != nil && source.GlobalConfig != nil {
+		defaultVendor = source.GlobalConfig.CloudProvider
+	}
+
+	if source != nil && source.NoInput {
+		if provider, ok := cloud.ByVendorKey(defaultVendor); ok {
+			return provider
+		}
+		source.recordMissing("CloudProvider")
+		return nil
+	}
+
+	providers := cloud.Providers()
+	names := make([]string, len(providers))
+	cursor := 0
+	for i, provider := range providers {
+		names[i] = provider.Name()
+		if provider.VendorKey() == defaultVendor {
+			cursor = i
+		}
+	}
+
 	providerPrompt := promptui.Select{
-		Label: "Select Cloud Provider",
-		Items: []string{"Amazon AWS", "Google GCP", "Microsoft Azure"},
+		Label:     "Select Cloud Provider",
+		Items:     names,
+		CursorPos: cursor,
 	}
 
 	_, providerResult, err := providerPrompt.Run()
@@ -253,9 +361,11 @@ func chooseCloudProvider(projectConfig *projectconfig.ZeroProjectConfig) {
 		exit.Fatal("Prompt failed %v\n", err)
 	}
 
-	if providerResult != "Amazon AWS" {
-		exit.Fatal("Only the AWS provider is available at this time")
+	provider, ok := cloud.ByName(providerResult)
+	if !ok {
+		exit.Fatal("Unknown cloud provider %q", providerResult)
 	}
+	return provider
 }
 
 func getRegistry() Registry {
@@ -280,10 +390,41 @@ func (registry Registry) availableLabels() []string {
 	return labels
 }
 
-func chooseStack(registry Registry) []string {
+func chooseStack(registry Registry, source *InputSource) []string {
+	if source != nil && source.Manifest != nil {
+		if len(source.Manifest.ModuleSources) > 0 {
+			return source.Manifest.ModuleSources
+		}
+		if source.Manifest.Stack != "" {
+			moduleSources, ok := registry[source.Manifest.Stack]
+			if !ok {
+				exit.Fatal("Manifest stack %q is not a known registry stack", source.Manifest.Stack)
+			}
+			return moduleSources
+		}
+	}
+	if source != nil && source.Resume != nil && len(source.Resume.ModuleSources) > 0 {
+		return source.Resume.ModuleSources
+	}
+
+	labels := registry.availableLabels()
+	defaultStack := ""
+	if source != nil && source.GlobalConfig != nil {
+		defaultStack = source.GlobalConfig.DefaultStack
+	}
+
+	if source != nil && source.NoInput {
+		if moduleSources, ok := registry[defaultStack]; ok {
+			return moduleSources
+		}
+		source.recordMissing("stack")
+		return nil
+	}
+
 	providerPrompt := promptui.Select{
-		Label: "Pick a stack you'd like to use",
-		Items: registry.availableLabels(),
+		Label:     "Pick a stack you'd like to use",
+		Items:     labels,
+		CursorPos: cursorPosOf(labels, defaultStack),
 	}
 	_, providerResult, err := providerPrompt.Run()
 	if err != nil {
@@ -293,31 +434,32 @@ func chooseStack(registry Registry) []string {
 	return registry[providerResult]
 }
 
-func fillProviderDetails(projectConfig *projectconfig.ZeroProjectConfig, s project.Secrets) {
-	if projectConfig.Infrastructure.AWS != nil {
-		sess, err := session.NewSession(&aws.Config{
-			Region:      aws.String(projectConfig.Infrastructure.AWS.Region),
-			Credentials: credentials.NewStaticCredentials(s.AWS.AccessKeyID, s.AWS.SecretAccessKey, ""),
-		})
-
-		svc := sts.New(sess)
-		input := &sts.GetCallerIdentityInput{}
-
-		awsCaller, err := svc.GetCallerIdentity(input)
-		if err != nil {
-			if aerr, ok := err.(awserr.Error); ok {
-				switch aerr.Code() {
-				default:
-					exit.Error(aerr.Error())
-				}
-			} else {
-				exit.Error(err.Error())
-			}
+// cursorPosOf returns the index of value within items, or 0 if it's not
+// present — used to pre-highlight a promptui.Select on a remembered default.
+func cursorPosOf(items []string, value string) int {
+	if value == "" {
+		return 0
+	}
+	for i, item := range items {
+		if item == value {
+			return i
 		}
+	}
+	return 0
+}
 
-		if awsCaller != nil && awsCaller.Account != nil {
-			projectConfig.Infrastructure.AWS.AccountID = *awsCaller.Account
-		}
+// fillProviderDetails validates the chosen provider's credentials and
+// populates its section of projectConfig.Infrastructure. Either step
+// failing is fatal — an unvalidated or unpopulated provider would leave
+// zero-project.yml missing its infrastructure section, which defeats the
+// point of requiring valid credentials in the first place.
+func fillProviderDetails(projectConfig *projectconfig.ZeroProjectConfig, provider cloud.Provider, values map[string]string) {
+	if err := provider.Validate(values); err != nil {
+		exit.Fatal("%v", err)
+	}
+
+	if err := provider.PopulateInfrastructure(projectConfig, values); err != nil {
+		exit.Fatal("%v", err)
 	}
 }
 
@@ -0,0 +1,217 @@
+package context
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/commitdev/zero/internal/config/globalconfig"
+)
+
+// InputSource lets `zero init` be driven without a TTY. PromptHandler.GetParam
+// resolves each field through it in order of precedence before falling
+// back to an interactive prompt:
+//
+//	env var (ZERO_PARAM_<FIELD> / ZERO_CRED_<VENDOR>_<FIELD>)
+//	  > --from-manifest file
+//	  > --resume'd zero-project.yml
+//	  > ~/.zero/config.yml global defaults
+//	  > the prompt's own hard-coded Default
+//
+// In NoInput mode, nothing is prompted at all — any field that can't be
+// resolved by a tier above the hard-coded Default is recorded and reported
+// back via Unresolved, even when it has a Default.
+type InputSource struct {
+	Manifest     *Manifest
+	Resume       *Manifest
+	GlobalConfig *globalconfig.GlobalConfig
+	NoInput      bool
+
+	missing []string
+}
+
+// NewInputSource builds an InputSource from --from-manifest/--resume
+// paths (either may be empty) plus ~/.zero/config.yml.
+func NewInputSource(manifestPath string, resumePath string, noInput bool) (*InputSource, error) {
+	source := &InputSource{NoInput: noInput}
+
+	globalConfig, err := globalconfig.LoadGlobalConfig()
+	if err != nil {
+		return nil, fmt.Errorf("unable to load global config: %w", err)
+	}
+	source.GlobalConfig = globalConfig
+
+	if manifestPath != "" {
+		manifest, err := LoadManifest(manifestPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load manifest %s: %w", manifestPath, err)
+		}
+		source.Manifest = manifest
+	}
+
+	if resumePath != "" {
+		resume, err := loadResumeManifest(resumePath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resume from %s: %w", resumePath, err)
+		}
+		source.Resume = resume
+	}
+
+	return source, nil
+}
+
+// resolve looks up field through the full precedence chain, short of the
+// prompt's own hard-coded Default: env var, then manifest, then resume,
+// then ~/.zero/config.yml. Any of these resolving the field means it is
+// never prompted for at all, interactively or otherwise — that's what
+// distinguishes this tier from the prompt's own Default.
+func (s *InputSource) resolve(field string) (string, bool) {
+	if value, ok := os.LookupEnv(envParamKey(field)); ok {
+		return value, true
+	}
+	if s.Manifest != nil {
+		if value, ok := resolveManifestField(s.Manifest, field); ok {
+			return value, true
+		}
+	}
+	if s.Resume != nil {
+		if value, ok := resolveManifestField(s.Resume, field); ok {
+			return value, true
+		}
+	}
+	if s.GlobalConfig != nil {
+		if value, ok := resolveGlobalConfigField(s.GlobalConfig, field); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// resolveCredential looks up a credential field for vendor through the
+// same precedence chain as resolve.
+func (s *InputSource) resolveCredential(vendor string, field string) (string, bool) {
+	if value, ok := os.LookupEnv(envCredKey(vendor, field)); ok {
+		return value, true
+	}
+	if s.Manifest != nil {
+		if value, ok := s.Manifest.Credentials[vendor][field]; ok {
+			return value, true
+		}
+	}
+	if s.Resume != nil {
+		if value, ok := s.Resume.Credentials[vendor][field]; ok {
+			return value, true
+		}
+	}
+	if s.GlobalConfig != nil {
+		if value, ok := resolveGlobalConfigCredential(s.GlobalConfig, vendor, field); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+func resolveManifestField(m *Manifest, field string) (string, bool) {
+	switch field {
+	case "projectName":
+		if m.ProjectName != "" {
+			return m.ProjectName, true
+		}
+		return "", false
+	case "ShouldPushRepositories":
+		if m.ShouldPushRepositories == nil {
+			return "", false
+		}
+		if *m.ShouldPushRepositories {
+			return "y", true
+		}
+		return "n", true
+	case "GithubRootOrg":
+		if m.GithubRootOrg != "" {
+			return m.GithubRootOrg, true
+		}
+		return "", false
+	}
+
+	// Per-module repo name: getProjectPrompts uses the module name itself
+	// as the field.
+	if module, ok := m.Modules[field]; ok && module.RepoName != "" {
+		return module.RepoName, true
+	}
+
+	// Module parameter values are keyed by field across all modules.
+	for _, module := range m.Modules {
+		if value, ok := module.Parameters[field]; ok {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+func resolveGlobalConfigField(g *globalconfig.GlobalConfig, field string) (string, bool) {
+	if field == "GithubRootOrg" && g.GithubRootOrg != "" {
+		return g.GithubRootOrg, true
+	}
+	return "", false
+}
+
+func resolveGlobalConfigCredential(g *globalconfig.GlobalConfig, vendor string, field string) (string, bool) {
+	switch vendor {
+	case "aws":
+		switch field {
+		case "accessKeyId":
+			return g.Credentials.AWSResourceConfig.AccessKeyId, g.Credentials.AWSResourceConfig.AccessKeyId != ""
+		case "secretAccessKey":
+			return g.Credentials.AWSResourceConfig.SecretAccessKey, g.Credentials.AWSResourceConfig.SecretAccessKey != ""
+		}
+	case "github":
+		if field == "accessToken" {
+			return g.Credentials.GithubResourceConfig.AccessToken, g.Credentials.GithubResourceConfig.AccessToken != ""
+		}
+	case "circleci":
+		if field == "apiKey" {
+			return g.Credentials.CircleCiResourceConfig.ApiKey, g.Credentials.CircleCiResourceConfig.ApiKey != ""
+		}
+	case "gcp":
+		switch field {
+		case "projectId":
+			return g.Credentials.GCPResourceConfig.ProjectID, g.Credentials.GCPResourceConfig.ProjectID != ""
+		case "serviceAccountKeyPath":
+			return g.Credentials.GCPResourceConfig.ServiceAccountKeyPath, g.Credentials.GCPResourceConfig.ServiceAccountKeyPath != ""
+		}
+	case "azure":
+		switch field {
+		case "subscriptionId":
+			return g.Credentials.AzureResourceConfig.SubscriptionID, g.Credentials.AzureResourceConfig.SubscriptionID != ""
+		case "tenantId":
+			return g.Credentials.AzureResourceConfig.TenantID, g.Credentials.AzureResourceConfig.TenantID != ""
+		}
+	}
+	return "", false
+}
+
+func (s *InputSource) recordMissing(field string) {
+	s.missing = append(s.missing, field)
+}
+
+// Unresolved returns an error listing every required field that --no-input
+// could not resolve, or nil if none.
+func (s *InputSource) Unresolved() error {
+	if len(s.missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("--no-input: missing required value(s) for: %s", strings.Join(s.missing, ", "))
+}
+
+func envParamKey(field string) string {
+	return "ZERO_PARAM_" + toEnvCase(field)
+}
+
+func envCredKey(vendor string, field string) string {
+	return fmt.Sprintf("ZERO_CRED_%s_%s", toEnvCase(vendor), toEnvCase(field))
+}
+
+func toEnvCase(s string) string {
+	return strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+}
@@ -0,0 +1,188 @@
+package context
+
+import (
+	"os"
+	"testing"
+
+	"github.com/commitdev/zero/internal/config/globalconfig"
+)
+
+func TestInputSourceResolvePrecedence(t *testing.T) {
+	manifest := &Manifest{GithubRootOrg: "github.com/from-manifest"}
+	resume := &Manifest{GithubRootOrg: "github.com/from-resume"}
+	global := &globalconfig.GlobalConfig{GithubRootOrg: "github.com/from-global"}
+
+	cases := []struct {
+		name     string
+		source   *InputSource
+		envValue string
+		want     string
+		wantOk   bool
+	}{
+		{
+			name:     "env takes priority over manifest, resume, and global config",
+			source:   &InputSource{Manifest: manifest, Resume: resume, GlobalConfig: global},
+			envValue: "github.com/from-env",
+			want:     "github.com/from-env",
+			wantOk:   true,
+		},
+		{
+			name:   "manifest takes priority over resume and global config",
+			source: &InputSource{Manifest: manifest, Resume: resume, GlobalConfig: global},
+			want:   "github.com/from-manifest",
+			wantOk: true,
+		},
+		{
+			name:   "resume takes priority over global config",
+			source: &InputSource{Resume: resume, GlobalConfig: global},
+			want:   "github.com/from-resume",
+			wantOk: true,
+		},
+		{
+			name:   "global config is the last resolvable tier",
+			source: &InputSource{GlobalConfig: global},
+			want:   "github.com/from-global",
+			wantOk: true,
+		},
+		{
+			name:   "nothing resolves without any tier populated",
+			source: &InputSource{},
+			wantOk: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.envValue != "" {
+				os.Setenv("ZERO_PARAM_GITHUBROOTORG", c.envValue)
+				defer os.Unsetenv("ZERO_PARAM_GITHUBROOTORG")
+			}
+
+			got, ok := c.source.resolve("GithubRootOrg")
+			if ok != c.wantOk {
+				t.Fatalf("resolve() ok = %v, want %v", ok, c.wantOk)
+			}
+			if got != c.want {
+				t.Fatalf("resolve() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestInputSourceResolveCredentialPrecedence(t *testing.T) {
+	manifest := &Manifest{Credentials: map[string]map[string]string{"aws": {"accessKeyId": "from-manifest"}}}
+	resume := &Manifest{Credentials: map[string]map[string]string{"aws": {"accessKeyId": "from-resume"}}}
+	global := &globalconfig.GlobalConfig{Credentials: globalconfig.ProjectCredential{
+		AWSResourceConfig: globalconfig.AWSResourceConfig{AccessKeyId: "from-global"},
+	}}
+
+	cases := []struct {
+		name     string
+		source   *InputSource
+		envValue string
+		want     string
+		wantOk   bool
+	}{
+		{
+			name:     "env takes priority over manifest, resume, and global config",
+			source:   &InputSource{Manifest: manifest, Resume: resume, GlobalConfig: global},
+			envValue: "from-env",
+			want:     "from-env",
+			wantOk:   true,
+		},
+		{
+			name:   "manifest takes priority over resume and global config",
+			source: &InputSource{Manifest: manifest, Resume: resume, GlobalConfig: global},
+			want:   "from-manifest",
+			wantOk: true,
+		},
+		{
+			name:   "resume takes priority over global config",
+			source: &InputSource{Resume: resume, GlobalConfig: global},
+			want:   "from-resume",
+			wantOk: true,
+		},
+		{
+			name:   "global config is the last resolvable tier",
+			source: &InputSource{GlobalConfig: global},
+			want:   "from-global",
+			wantOk: true,
+		},
+		{
+			name:   "nothing resolves without any tier populated",
+			source: &InputSource{},
+			wantOk: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.envValue != "" {
+				os.Setenv("ZERO_CRED_AWS_ACCESSKEYID", c.envValue)
+				defer os.Unsetenv("ZERO_CRED_AWS_ACCESSKEYID")
+			}
+
+			got, ok := c.source.resolveCredential("aws", "accessKeyId")
+			if ok != c.wantOk {
+				t.Fatalf("resolveCredential() ok = %v, want %v", ok, c.wantOk)
+			}
+			if got != c.want {
+				t.Fatalf("resolveCredential() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestResolveManifestFieldModuleLookups(t *testing.T) {
+	shouldPush := true
+	manifest := &Manifest{
+		ShouldPushRepositories: &shouldPush,
+		Modules: map[string]ManifestModule{
+			"backend": {
+				RepoName:   "my-backend",
+				Parameters: map[string]string{"dbName": "widgets"},
+			},
+		},
+	}
+
+	cases := []struct {
+		field  string
+		want   string
+		wantOk bool
+	}{
+		{field: "ShouldPushRepositories", want: "y", wantOk: true},
+		{field: "backend", want: "my-backend", wantOk: true},
+		{field: "dbName", want: "widgets", wantOk: true},
+		{field: "unknownField", want: "", wantOk: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.field, func(t *testing.T) {
+			got, ok := resolveManifestField(manifest, c.field)
+			if ok != c.wantOk || got != c.want {
+				t.Fatalf("resolveManifestField(%q) = (%q, %v), want (%q, %v)", c.field, got, ok, c.want, c.wantOk)
+			}
+		})
+	}
+}
+
+func TestUnresolved(t *testing.T) {
+	source := &InputSource{}
+
+	if err := source.Unresolved(); err != nil {
+		t.Fatalf("Unresolved() = %v, want nil when nothing is missing", err)
+	}
+
+	source.recordMissing("projectName")
+	source.recordMissing("GithubRootOrg")
+
+	err := source.Unresolved()
+	if err == nil {
+		t.Fatal("Unresolved() = nil, want an error listing the missing fields")
+	}
+
+	want := "--no-input: missing required value(s) for: projectName, GithubRootOrg"
+	if err.Error() != want {
+		t.Fatalf("Unresolved() = %q, want %q", err.Error(), want)
+	}
+}
@@ -0,0 +1,92 @@
+package context
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/commitdev/zero/internal/config/projectconfig"
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest is the on-disk, non-interactive description of a `zero init`
+// run: everything a user would otherwise be prompted for. It's loaded via
+// `zero init --from-manifest <path>` and consulted by PromptHandler.GetParam
+// before anything is asked interactively.
+type Manifest struct {
+	ProjectName            string                       `yaml:"projectName"`
+	Stack                  string                       `yaml:"stack,omitempty"`
+	ModuleSources          []string                     `yaml:"moduleSources,omitempty"`
+	ShouldPushRepositories *bool                        `yaml:"shouldPushRepositories,omitempty"`
+	GithubRootOrg          string                       `yaml:"githubRootOrg,omitempty"`
+	CloudProvider          string                       `yaml:"cloudProvider,omitempty"`
+	Modules                map[string]ManifestModule    `yaml:"modules,omitempty"`
+	Credentials            map[string]map[string]string `yaml:"credentials,omitempty"`
+}
+
+// ManifestModule is a single module's section of the manifest: the repo
+// name to create it under and its parameter values.
+type ManifestModule struct {
+	RepoName   string            `yaml:"repoName,omitempty"`
+	Parameters map[string]string `yaml:"parameters,omitempty"`
+}
+
+// LoadManifest reads and parses a manifest file from path.
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	if err := yaml.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// loadResumeManifest reads a previously written zero-project.yml (path may
+// point at the file itself or at its containing project directory) and
+// recasts it as a Manifest, so `zero init --resume` can feed its already-
+// resolved values back through the same PromptHandler.GetParam chain.
+func loadResumeManifest(path string) (*Manifest, error) {
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		path = filepath.Join(path, projectconfig.Filename)
+	}
+
+	existing, err := projectconfig.Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	shouldPush := existing.ShouldPushRepositories
+	manifest := &Manifest{
+		ProjectName:            existing.Name,
+		ShouldPushRepositories: &shouldPush,
+		GithubRootOrg:          existing.GithubRootOrg,
+		ModuleSources:          existing.ModuleSources,
+		CloudProvider:          resumeCloudProvider(existing.Infrastructure),
+		Modules:                map[string]ManifestModule{},
+	}
+	for moduleName, module := range existing.Modules {
+		manifest.Modules[moduleName] = ManifestModule{
+			RepoName:   module.RepoName,
+			Parameters: module.Parameters,
+		}
+	}
+	return manifest, nil
+}
+
+// resumeCloudProvider infers the vendor key of whichever provider a
+// previous run populated, so --resume doesn't re-prompt for it.
+func resumeCloudProvider(infra projectconfig.Infrastructure) string {
+	switch {
+	case infra.AWS != nil:
+		return "aws"
+	case infra.GCP != nil:
+		return "gcp"
+	case infra.Azure != nil:
+		return "azure"
+	}
+	return ""
+}
@@ -0,0 +1,42 @@
+package context
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/commitdev/zero/internal/config/projectconfig"
+)
+
+// TestLoadResumeManifestRoundTripsModuleSources writes a zero-project.yml
+// the way Init does, reloads it via loadResumeManifest, and checks that
+// chooseStack picks the saved module sources back up instead of
+// re-prompting — the scenario `--resume` exists for.
+func TestLoadResumeManifestRoundTripsModuleSources(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), projectconfig.Filename)
+	saved := &projectconfig.ZeroProjectConfig{
+		Name:          "widgets",
+		GithubRootOrg: "github.com/widgets-co",
+		ModuleSources: []string{"github.com/commitdev/zero-aws-eks-stack"},
+	}
+	if err := projectconfig.Save(configPath, saved); err != nil {
+		t.Fatalf("Save() = %v", err)
+	}
+
+	manifest, err := loadResumeManifest(configPath)
+	if err != nil {
+		t.Fatalf("loadResumeManifest() = %v", err)
+	}
+
+	if manifest.GithubRootOrg != saved.GithubRootOrg {
+		t.Fatalf("GithubRootOrg = %q, want %q", manifest.GithubRootOrg, saved.GithubRootOrg)
+	}
+	if len(manifest.ModuleSources) != 1 || manifest.ModuleSources[0] != saved.ModuleSources[0] {
+		t.Fatalf("ModuleSources = %v, want %v", manifest.ModuleSources, saved.ModuleSources)
+	}
+
+	source := &InputSource{Resume: manifest}
+	moduleSources := chooseStack(getRegistry(), source)
+	if len(moduleSources) != 1 || moduleSources[0] != saved.ModuleSources[0] {
+		t.Fatalf("chooseStack() = %v, want %v", moduleSources, saved.ModuleSources)
+	}
+}
@@ -0,0 +1,112 @@
+package context
+
+import (
+	"fmt"
+
+	"github.com/commitdev/zero/internal/config/moduleconfig"
+	"github.com/commitdev/zero/pkg/util/exit"
+	"github.com/manifoldco/promptui"
+)
+
+// PromptHandler describes a single value to collect from the user: the
+// parameter itself, whether it should be asked at all (Condition), and how
+// to validate whatever value it resolves to.
+type PromptHandler struct {
+	moduleconfig.Parameter
+	Condition func(values map[string]string) bool
+	Validate  func(input string) error
+	// Vendor is set for credential prompts so GetParam can resolve them
+	// from ZERO_CRED_<VENDOR>_<FIELD> / the manifest's credentials section
+	// instead of the generic parameter lookup.
+	Vendor string
+}
+
+// GetParam resolves the handler's value. When source is non-nil it is
+// consulted first (environment variable, manifest, resumed config, then
+// ~/.zero/config.yml); only when it
+// can't resolve the field does GetParam fall back to an interactive
+// promptui prompt. In --no-input mode, an unresolved required field is
+// recorded on source instead of prompting.
+func (p PromptHandler) GetParam(values map[string]string, source *InputSource) string {
+	if !p.Condition(values) {
+		return ""
+	}
+
+	if source != nil {
+		var resolved string
+		var ok bool
+		if p.Vendor != "" {
+			resolved, ok = source.resolveCredential(p.Vendor, p.Field)
+		} else {
+			resolved, ok = source.resolve(p.Field)
+		}
+		if ok {
+			if err := p.Validate(resolved); err != nil {
+				exit.Fatal("Invalid value for %s: %v", p.Field, err)
+			}
+			return resolved
+		}
+		if source.NoInput {
+			source.recordMissing(p.Field)
+			return p.Default
+		}
+	}
+
+	prompt := promptui.Prompt{
+		Label:    p.Label,
+		Default:  p.Default,
+		Validate: p.Validate,
+	}
+
+	result, err := prompt.Run()
+	if err != nil {
+		exit.Fatal("Prompt failed %v\n", err)
+	}
+	return result
+}
+
+// NoCondition always asks the prompt.
+func NoCondition(values map[string]string) bool {
+	return true
+}
+
+// NoValidation accepts any value.
+func NoValidation(input string) error {
+	return nil
+}
+
+// KeyMatchCondition only asks the prompt when values[key] == value.
+func KeyMatchCondition(key string, value string) func(values map[string]string) bool {
+	return func(values map[string]string) bool {
+		return values[key] == value
+	}
+}
+
+// SpecificValueValidation rejects any input that isn't one of allowed.
+func SpecificValueValidation(allowed ...string) func(input string) error {
+	return func(input string) error {
+		for _, value := range allowed {
+			if input == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("value must be one of %v", allowed)
+	}
+}
+
+// appendToSet appends items to set, skipping any that are already present.
+func appendToSet(set []string, items []string) []string {
+	for _, item := range items {
+		found := false
+		for _, existing := range set {
+			if existing == item {
+				found = true
+				break
+			}
+		}
+		if !found {
+			set = append(set, item)
+		}
+	}
+	return set
+}
@@ -0,0 +1,15 @@
+package context
+
+import "github.com/commitdev/zero/internal/config/moduleconfig"
+
+// PromptModuleParams resolves every parameter a module declares, merging
+// the results into values and returning the updated map. Each parameter
+// is asked unconditionally with its own declared Default; a field that an
+// earlier module already set is resolved again and overwritten.
+func PromptModuleParams(config moduleconfig.ModuleConfig, values map[string]string, source *InputSource) (map[string]string, error) {
+	for _, parameter := range config.Parameters {
+		handler := PromptHandler{Parameter: parameter, Condition: NoCondition, Validate: NoValidation}
+		values[parameter.Field] = handler.GetParam(values, source)
+	}
+	return values, nil
+}
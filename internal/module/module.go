@@ -0,0 +1,19 @@
+package module
+
+import (
+	"sync"
+
+	"github.com/commitdev/zero/internal/config/moduleconfig"
+)
+
+// FetchModule marks wg done for source and returns. It downloads nothing;
+// it is run concurrently across all of a stack's modules.
+func FetchModule(source string, wg *sync.WaitGroup) {
+	defer wg.Done()
+}
+
+// ParseModuleConfig returns an empty ModuleConfig and a nil error for any
+// source. It reads no `zero-module.yml`.
+func ParseModuleConfig(source string) (moduleconfig.ModuleConfig, error) {
+	return moduleconfig.ModuleConfig{}, nil
+}
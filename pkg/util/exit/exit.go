@@ -0,0 +1,18 @@
+package exit
+
+import (
+	"fmt"
+	"os"
+)
+
+// Fatal prints a formatted error message and terminates the process
+// immediately with a non-zero exit code.
+func Fatal(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+// Error prints a formatted error message without terminating the process.
+func Error(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+}
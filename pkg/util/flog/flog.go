@@ -0,0 +1,8 @@
+package flog
+
+import "fmt"
+
+// Infof prints a formatted, user-facing progress message.
+func Infof(format string, args ...interface{}) {
+	fmt.Printf(format+"\n", args...)
+}